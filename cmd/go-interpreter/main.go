@@ -0,0 +1,77 @@
+// Command go-interpreter is the CLI entry point for the interpreter's
+// developer tooling.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/go-interpreter/internal/ast"
+	"github.com/go-interpreter/internal/parser"
+	"github.com/go-interpreter/internal/scanner"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, "Usage: go-interpreter <command> [arguments]")
+		os.Exit(64)
+	}
+
+	var err error
+	switch command := os.Args[1]; command {
+	case "dumpast":
+		if len(os.Args) < 3 {
+			fmt.Fprintln(os.Stderr, "Usage: go-interpreter dumpast <file>")
+			os.Exit(64)
+		}
+		err = dumpAST(os.Args[2])
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown command %q\n", command)
+		os.Exit(64)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// dumpAST scans and parses filename, then writes the resulting statements
+// to stdout as a JSON array, so external tooling (golden-file tests, a
+// language server) can consume the parser's output without linking
+// against Go.
+//
+// Scanning and parsing both recover from errors (see errors.ParseErrors)
+// rather than stopping at the first one, so a file with a bad line still
+// yields every statement that parsed cleanly. dumpAST dumps that partial
+// result instead of discarding it, returning the scan/parse error only
+// after the JSON has been written so the caller still sees a non-zero
+// exit status.
+func dumpAST(filename string) error {
+	source, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+
+	tokens, scanErr := scanner.NewWithFile(string(source), filename).ScanTokens()
+
+	p := parser.NewParserWithFile(tokens, filename)
+	statements, parseErr := p.Parse()
+
+	dump := make([]map[string]interface{}, len(statements))
+	for i, statement := range statements {
+		dump[i] = ast.ToJSONObject(statement)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(dump); err != nil {
+		return err
+	}
+
+	if scanErr != nil {
+		return scanErr
+	}
+	return parseErr
+}
@@ -0,0 +1,100 @@
+// Package ast defines the syntax tree node types produced by the parser.
+package ast
+
+import "github.com/go-interpreter/internal/token"
+
+// Expr is implemented by every expression node in the AST.
+type Expr interface {
+	exprNode()
+}
+
+// Stmt is implemented by every statement node in the AST.
+type Stmt interface {
+	stmtNode()
+}
+
+// Binary represents a binary operator expression, e.g. `left op right`.
+type Binary struct {
+	Left     Expr
+	Operator token.Token
+	Right    Expr
+	Pos      Pos
+}
+
+func (Binary) exprNode() {}
+
+// Unary represents a unary operator expression, e.g. `-right` or `!right`.
+type Unary struct {
+	Operator token.Token
+	Right    Expr
+	Pos      Pos
+}
+
+func (Unary) exprNode() {}
+
+// Grouping represents a parenthesized expression, e.g. `(expression)`.
+type Grouping struct {
+	Expression Expr
+	Pos        Pos
+}
+
+func (Grouping) exprNode() {}
+
+// Literal represents a literal value: a number, string, boolean, or nil.
+type Literal struct {
+	Value interface{}
+	Pos   Pos
+}
+
+func (Literal) exprNode() {}
+
+// Variable represents a reference to a variable by name.
+type Variable struct {
+	Name token.Token
+	Pos  Pos
+}
+
+func (Variable) exprNode() {}
+
+// Assign represents an assignment expression, e.g. `name = value`.
+type Assign struct {
+	Name  token.Token
+	Value Expr
+	Pos   Pos
+}
+
+func (Assign) exprNode() {}
+
+// PrintStmt represents a `print expression;` statement.
+type PrintStmt struct {
+	Expression Expr
+	Pos        Pos
+}
+
+func (PrintStmt) stmtNode() {}
+
+// ExpressionStmt represents a bare expression used as a statement.
+type ExpressionStmt struct {
+	Expression Expr
+	Pos        Pos
+}
+
+func (ExpressionStmt) stmtNode() {}
+
+// VarStmt represents a variable declaration, e.g. `var name = initializer;`.
+// Initializer is nil when the declaration has no initializer.
+type VarStmt struct {
+	Name        token.Token
+	Initializer Expr
+	Pos         Pos
+}
+
+func (VarStmt) stmtNode() {}
+
+// BlockStmt represents a `{ ... }` block introducing a new scope.
+type BlockStmt struct {
+	Statements []Stmt
+	Pos        Pos
+}
+
+func (BlockStmt) stmtNode() {}
@@ -0,0 +1,410 @@
+package ast
+
+import (
+	"fmt"
+
+	"github.com/go-interpreter/internal/token"
+)
+
+// Node is implemented by every AST node, expression or statement. It exists
+// so that generic tooling (JSON (de)serialization, a future pretty-printer)
+// can operate over the tree without caring whether a given node is an Expr
+// or a Stmt.
+type Node interface{}
+
+// ToJSONObject converts node into a JSON-friendly representation: a "kind"
+// discriminator, child nodes nested under "children" (recursively converted
+// the same way), and operator/literal/position metadata under "meta". A nil
+// node (e.g. a VarStmt with no initializer) converts to a nil map.
+func ToJSONObject(node Node) map[string]interface{} {
+	if node == nil {
+		return nil
+	}
+
+	switch n := node.(type) {
+	case Binary:
+		return map[string]interface{}{
+			"kind": "Binary",
+			"children": map[string]interface{}{
+				"left":  ToJSONObject(n.Left),
+				"right": ToJSONObject(n.Right),
+			},
+			"meta": map[string]interface{}{
+				"operator": tokenToJSON(n.Operator),
+				"pos":      posToJSON(n.Pos),
+			},
+		}
+	case Unary:
+		return map[string]interface{}{
+			"kind": "Unary",
+			"children": map[string]interface{}{
+				"right": ToJSONObject(n.Right),
+			},
+			"meta": map[string]interface{}{
+				"operator": tokenToJSON(n.Operator),
+				"pos":      posToJSON(n.Pos),
+			},
+		}
+	case Grouping:
+		return map[string]interface{}{
+			"kind": "Grouping",
+			"children": map[string]interface{}{
+				"expression": ToJSONObject(n.Expression),
+			},
+			"meta": map[string]interface{}{
+				"pos": posToJSON(n.Pos),
+			},
+		}
+	case Literal:
+		return map[string]interface{}{
+			"kind":     "Literal",
+			"children": map[string]interface{}{},
+			"meta":     literalMetaToJSON(n),
+		}
+	case Variable:
+		return map[string]interface{}{
+			"kind":     "Variable",
+			"children": map[string]interface{}{},
+			"meta": map[string]interface{}{
+				"name": tokenToJSON(n.Name),
+				"pos":  posToJSON(n.Pos),
+			},
+		}
+	case Assign:
+		return map[string]interface{}{
+			"kind": "Assign",
+			"children": map[string]interface{}{
+				"value": ToJSONObject(n.Value),
+			},
+			"meta": map[string]interface{}{
+				"name": tokenToJSON(n.Name),
+				"pos":  posToJSON(n.Pos),
+			},
+		}
+	case PrintStmt:
+		return map[string]interface{}{
+			"kind": "PrintStmt",
+			"children": map[string]interface{}{
+				"expression": ToJSONObject(n.Expression),
+			},
+			"meta": map[string]interface{}{
+				"pos": posToJSON(n.Pos),
+			},
+		}
+	case ExpressionStmt:
+		return map[string]interface{}{
+			"kind": "ExpressionStmt",
+			"children": map[string]interface{}{
+				"expression": ToJSONObject(n.Expression),
+			},
+			"meta": map[string]interface{}{
+				"pos": posToJSON(n.Pos),
+			},
+		}
+	case VarStmt:
+		return map[string]interface{}{
+			"kind": "VarStmt",
+			"children": map[string]interface{}{
+				"initializer": ToJSONObject(n.Initializer),
+			},
+			"meta": map[string]interface{}{
+				"name": tokenToJSON(n.Name),
+				"pos":  posToJSON(n.Pos),
+			},
+		}
+	case BlockStmt:
+		var statements interface{}
+		if n.Statements != nil {
+			list := make([]interface{}, len(n.Statements))
+			for i, stmt := range n.Statements {
+				list[i] = ToJSONObject(stmt)
+			}
+			statements = list
+		}
+		return map[string]interface{}{
+			"kind": "BlockStmt",
+			"children": map[string]interface{}{
+				"statements": statements,
+			},
+			"meta": map[string]interface{}{
+				"pos": posToJSON(n.Pos),
+			},
+		}
+	default:
+		return map[string]interface{}{
+			"kind": fmt.Sprintf("Unknown(%T)", n),
+		}
+	}
+}
+
+// FromJSONObject reverses ToJSONObject, reconstructing the Node the object
+// describes. It returns an error if the object's "kind" is missing or
+// unrecognized, or if its children/meta are malformed.
+func FromJSONObject(raw map[string]interface{}) (Node, error) {
+	if raw == nil {
+		return nil, nil
+	}
+
+	kind, _ := raw["kind"].(string)
+	children, _ := raw["children"].(map[string]interface{})
+	meta, _ := raw["meta"].(map[string]interface{})
+
+	switch kind {
+	case "Binary":
+		left, err := childExpr(children, "left")
+		if err != nil {
+			return nil, err
+		}
+		right, err := childExpr(children, "right")
+		if err != nil {
+			return nil, err
+		}
+		operator, err := tokenFromJSON(meta["operator"])
+		if err != nil {
+			return nil, err
+		}
+		return Binary{Left: left, Operator: operator, Right: right, Pos: posFromJSON(meta["pos"])}, nil
+
+	case "Unary":
+		right, err := childExpr(children, "right")
+		if err != nil {
+			return nil, err
+		}
+		operator, err := tokenFromJSON(meta["operator"])
+		if err != nil {
+			return nil, err
+		}
+		return Unary{Operator: operator, Right: right, Pos: posFromJSON(meta["pos"])}, nil
+
+	case "Grouping":
+		expr, err := childExpr(children, "expression")
+		if err != nil {
+			return nil, err
+		}
+		return Grouping{Expression: expr, Pos: posFromJSON(meta["pos"])}, nil
+
+	case "Literal":
+		value, err := literalMetaFromJSON(meta)
+		if err != nil {
+			return nil, err
+		}
+		return Literal{Value: value, Pos: posFromJSON(meta["pos"])}, nil
+
+	case "Variable":
+		name, err := tokenFromJSON(meta["name"])
+		if err != nil {
+			return nil, err
+		}
+		return Variable{Name: name, Pos: posFromJSON(meta["pos"])}, nil
+
+	case "Assign":
+		value, err := childExpr(children, "value")
+		if err != nil {
+			return nil, err
+		}
+		name, err := tokenFromJSON(meta["name"])
+		if err != nil {
+			return nil, err
+		}
+		return Assign{Name: name, Value: value, Pos: posFromJSON(meta["pos"])}, nil
+
+	case "PrintStmt":
+		expr, err := childExpr(children, "expression")
+		if err != nil {
+			return nil, err
+		}
+		return PrintStmt{Expression: expr, Pos: posFromJSON(meta["pos"])}, nil
+
+	case "ExpressionStmt":
+		expr, err := childExpr(children, "expression")
+		if err != nil {
+			return nil, err
+		}
+		return ExpressionStmt{Expression: expr, Pos: posFromJSON(meta["pos"])}, nil
+
+	case "VarStmt":
+		initializer, err := childExpr(children, "initializer")
+		if err != nil {
+			return nil, err
+		}
+		name, err := tokenFromJSON(meta["name"])
+		if err != nil {
+			return nil, err
+		}
+		return VarStmt{Name: name, Initializer: initializer, Pos: posFromJSON(meta["pos"])}, nil
+
+	case "BlockStmt":
+		statements, err := childStmts(children, "statements")
+		if err != nil {
+			return nil, err
+		}
+		return BlockStmt{Statements: statements, Pos: posFromJSON(meta["pos"])}, nil
+
+	default:
+		return nil, fmt.Errorf("ast: unknown node kind %q", kind)
+	}
+}
+
+// childExpr extracts and reconstructs the Expr stored under children[key],
+// returning nil if it's absent (e.g. a VarStmt with no initializer).
+func childExpr(children map[string]interface{}, key string) (Expr, error) {
+	raw, ok := children[key]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+	obj, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("ast: expected object for %q, got %T", key, raw)
+	}
+	node, err := FromJSONObject(obj)
+	if err != nil {
+		return nil, err
+	}
+	if node == nil {
+		return nil, nil
+	}
+	expr, ok := node.(Expr)
+	if !ok {
+		return nil, fmt.Errorf("ast: expected expression for %q, got %T", key, node)
+	}
+	return expr, nil
+}
+
+// childStmts extracts and reconstructs the []Stmt stored under
+// children[key].
+func childStmts(children map[string]interface{}, key string) ([]Stmt, error) {
+	raw, ok := children[key]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+	list, ok := raw.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("ast: expected array for %q, got %T", key, raw)
+	}
+	statements := make([]Stmt, 0, len(list))
+	for _, item := range list {
+		obj, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("ast: expected object in %q array, got %T", key, item)
+		}
+		node, err := FromJSONObject(obj)
+		if err != nil {
+			return nil, err
+		}
+		stmt, ok := node.(Stmt)
+		if !ok {
+			return nil, fmt.Errorf("ast: expected statement in %q array, got %T", key, node)
+		}
+		statements = append(statements, stmt)
+	}
+	return statements, nil
+}
+
+// literalMetaToJSON builds a Literal's meta object, tagging its value with
+// a type discriminator so nil/true/false, numbers, and strings round-trip
+// through JSON without ambiguity.
+func literalMetaToJSON(n Literal) map[string]interface{} {
+	meta := map[string]interface{}{"pos": posToJSON(n.Pos)}
+	switch v := n.Value.(type) {
+	case nil:
+		meta["type"] = "nil"
+	case bool:
+		meta["type"] = "bool"
+		meta["value"] = v
+	case float64:
+		meta["type"] = "number"
+		meta["value"] = v
+	case string:
+		meta["type"] = "string"
+		meta["value"] = v
+	default:
+		meta["type"] = "string"
+		meta["value"] = fmt.Sprintf("%v", v)
+	}
+	return meta
+}
+
+// literalMetaFromJSON reverses literalMetaToJSON, using the "type"
+// discriminator to decode "value" back to the right Go type.
+func literalMetaFromJSON(meta map[string]interface{}) (interface{}, error) {
+	valueType, _ := meta["type"].(string)
+	switch valueType {
+	case "nil":
+		return nil, nil
+	case "bool":
+		value, _ := meta["value"].(bool)
+		return value, nil
+	case "number":
+		switch v := meta["value"].(type) {
+		case float64:
+			return v, nil
+		case int:
+			return float64(v), nil
+		default:
+			return nil, fmt.Errorf("ast: expected number literal value, got %T", meta["value"])
+		}
+	case "string":
+		value, _ := meta["value"].(string)
+		return value, nil
+	default:
+		return nil, fmt.Errorf("ast: unknown literal type %q", valueType)
+	}
+}
+
+// tokenToJSON captures the fields of tok needed to reconstruct it exactly.
+func tokenToJSON(tok token.Token) map[string]interface{} {
+	return map[string]interface{}{
+		"type":   int(tok.Type),
+		"lexeme": tok.Lexeme,
+		"line":   tok.Line,
+		"char":   tok.Char,
+	}
+}
+
+// tokenFromJSON reverses tokenToJSON.
+func tokenFromJSON(raw interface{}) (token.Token, error) {
+	obj, ok := raw.(map[string]interface{})
+	if !ok {
+		return token.Token{}, fmt.Errorf("ast: expected token object, got %T", raw)
+	}
+	lexeme, _ := obj["lexeme"].(string)
+	return token.Token{
+		Type:   token.TokenType(toInt(obj["type"])),
+		Lexeme: lexeme,
+		Line:   toInt(obj["line"]),
+		Char:   toInt(obj["char"]),
+	}, nil
+}
+
+// posToJSON converts a Pos to its JSON representation.
+func posToJSON(p Pos) map[string]interface{} {
+	return map[string]interface{}{
+		"filename": p.Filename,
+		"line":     p.Line,
+		"column":   p.Column,
+	}
+}
+
+// posFromJSON reverses posToJSON, returning InitPos if raw isn't a valid
+// position object.
+func posFromJSON(raw interface{}) Pos {
+	obj, ok := raw.(map[string]interface{})
+	if !ok {
+		return InitPos
+	}
+	filename, _ := obj["filename"].(string)
+	return Pos{Filename: filename, Line: toInt(obj["line"]), Column: toInt(obj["column"])}
+}
+
+// toInt accepts either a float64 (as produced by encoding/json) or an int
+// (as produced by code constructing these maps directly) and normalizes it.
+func toInt(v interface{}) int {
+	switch n := v.(type) {
+	case float64:
+		return int(n)
+	case int:
+		return n
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,117 @@
+package ast
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/go-interpreter/internal/token"
+)
+
+// TestJSONRoundTrip asserts that every node kind ToJSONObject knows how to
+// encode, FromJSONObject can decode back to an equal value, after a real
+// json.Marshal/Unmarshal pass rather than just the Go maps in between. This
+// is the regression a new ast node would trip if ToJSONObject or
+// FromJSONObject forgot a case for it.
+func TestJSONRoundTrip(t *testing.T) {
+	pos := Pos{Filename: "test.lox", Line: 1, Column: 2}
+
+	tests := []struct {
+		name string
+		node Node
+	}{
+		{name: "Literal number", node: Literal{Value: 1.5, Pos: pos}},
+		{name: "Literal string", node: Literal{Value: "hi", Pos: pos}},
+		{name: "Literal bool", node: Literal{Value: true, Pos: pos}},
+		{name: "Literal nil", node: Literal{Value: nil, Pos: pos}},
+		{
+			name: "Variable",
+			node: Variable{Name: token.Token{Type: token.IDENTIFIER, Lexeme: "x", Line: 1, Char: 2}, Pos: pos},
+		},
+		{
+			name: "Unary",
+			node: Unary{
+				Operator: token.Token{Type: token.MINUS, Lexeme: "-", Line: 1, Char: 1},
+				Right:    Literal{Value: 2.0, Pos: pos},
+				Pos:      pos,
+			},
+		},
+		{
+			name: "Binary",
+			node: Binary{
+				Left:     Literal{Value: 1.0, Pos: pos},
+				Operator: token.Token{Type: token.PLUS, Lexeme: "+", Line: 1, Char: 3},
+				Right:    Literal{Value: 2.0, Pos: pos},
+				Pos:      pos,
+			},
+		},
+		{
+			name: "Grouping",
+			node: Grouping{Expression: Literal{Value: 1.0, Pos: pos}, Pos: pos},
+		},
+		{
+			name: "Assign",
+			node: Assign{
+				Name:  token.Token{Type: token.IDENTIFIER, Lexeme: "x", Line: 1, Char: 1},
+				Value: Literal{Value: 3.0, Pos: pos},
+				Pos:   pos,
+			},
+		},
+		{
+			name: "PrintStmt",
+			node: PrintStmt{Expression: Literal{Value: "hi", Pos: pos}, Pos: pos},
+		},
+		{
+			name: "ExpressionStmt",
+			node: ExpressionStmt{Expression: Literal{Value: 1.0, Pos: pos}, Pos: pos},
+		},
+		{
+			name: "VarStmt with initializer",
+			node: VarStmt{
+				Name:        token.Token{Type: token.IDENTIFIER, Lexeme: "x", Line: 1, Char: 5},
+				Initializer: Literal{Value: 1.0, Pos: pos},
+				Pos:         pos,
+			},
+		},
+		{
+			name: "VarStmt without initializer",
+			node: VarStmt{Name: token.Token{Type: token.IDENTIFIER, Lexeme: "x", Line: 1, Char: 5}, Pos: pos},
+		},
+		{
+			name: "BlockStmt",
+			node: BlockStmt{
+				Statements: []Stmt{
+					ExpressionStmt{Expression: Literal{Value: 1.0, Pos: pos}, Pos: pos},
+					PrintStmt{Expression: Literal{Value: "hi", Pos: pos}, Pos: pos},
+				},
+				Pos: pos,
+			},
+		},
+		{
+			name: "BlockStmt empty",
+			node: BlockStmt{Statements: nil, Pos: pos},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded, err := json.Marshal(ToJSONObject(tt.node))
+			if err != nil {
+				t.Fatalf("json.Marshal: %v", err)
+			}
+
+			var raw map[string]interface{}
+			if err := json.Unmarshal(encoded, &raw); err != nil {
+				t.Fatalf("json.Unmarshal: %v", err)
+			}
+
+			got, err := FromJSONObject(raw)
+			if err != nil {
+				t.Fatalf("FromJSONObject: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.node) {
+				t.Errorf("round trip mismatch:\n got:  %#v\n want: %#v", got, tt.node)
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package ast
+
+// Pos describes a location in a source file, identifying the file, line,
+// and column a node was parsed from. It is attached to every AST node so
+// that later tooling (error messages, debuggers, an LSP) can point back
+// into the original source precisely.
+type Pos struct {
+	Filename string
+	Line     int
+	Column   int
+}
+
+// InitPos is the zero-value Pos used for synthetic nodes that don't
+// originate from a specific point in any source file.
+var InitPos = Pos{}
@@ -0,0 +1,62 @@
+// Package environment implements the runtime variable scopes that an
+// interpreter would use to store and resolve bindings introduced by
+// declarations, assignments, and blocks. No interpreter exists in this tree
+// yet (the parser only produces an AST), so nothing here is wired up or
+// exercised outside this package's own tests; it's in place for whichever
+// request adds tree-walking evaluation next.
+package environment
+
+import "fmt"
+
+// Environment holds the variable bindings for a single lexical scope. A
+// nil Enclosing marks the global scope; any other scope chains up to its
+// enclosing environment so that inner blocks can see outer variables while
+// shadowing them locally.
+type Environment struct {
+	Enclosing *Environment
+	values    map[string]interface{}
+}
+
+// New creates a new global environment with no enclosing scope.
+func New() *Environment {
+	return &Environment{values: make(map[string]interface{})}
+}
+
+// NewEnclosed creates a new environment nested inside enclosing, used when
+// entering a block.
+func NewEnclosed(enclosing *Environment) *Environment {
+	return &Environment{Enclosing: enclosing, values: make(map[string]interface{})}
+}
+
+// Define binds name to value in this environment, overwriting any existing
+// binding of the same name in this scope.
+func (env *Environment) Define(name string, value interface{}) {
+	env.values[name] = value
+}
+
+// Get looks up name, searching enclosing scopes if it isn't found locally.
+// It returns an error if name is not bound anywhere in the chain.
+func (env *Environment) Get(name string) (interface{}, error) {
+	if value, ok := env.values[name]; ok {
+		return value, nil
+	}
+	if env.Enclosing != nil {
+		return env.Enclosing.Get(name)
+	}
+	return nil, fmt.Errorf("undefined variable '%s'", name)
+}
+
+// Assign updates the value of an already-declared variable, searching
+// enclosing scopes if it isn't found locally. It returns an error if name is
+// not bound anywhere in the chain; unlike Define, it never creates a new
+// binding.
+func (env *Environment) Assign(name string, value interface{}) error {
+	if _, ok := env.values[name]; ok {
+		env.values[name] = value
+		return nil
+	}
+	if env.Enclosing != nil {
+		return env.Enclosing.Assign(name, value)
+	}
+	return fmt.Errorf("undefined variable '%s'", name)
+}
@@ -0,0 +1,116 @@
+package environment
+
+import "testing"
+
+func TestEnvironmentDefineAndGet(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+	}{
+		{name: "a", value: 1.0},
+		{name: "b", value: "hello"},
+		{name: "c", value: true},
+		{name: "d", value: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := New()
+			env.Define(tt.name, tt.value)
+
+			got, err := env.Get(tt.name)
+			if err != nil {
+				t.Fatalf("Get(%q) returned error: %v", tt.name, err)
+			}
+			if got != tt.value {
+				t.Errorf("Get(%q) = %v, want %v", tt.name, got, tt.value)
+			}
+		})
+	}
+}
+
+func TestEnvironmentGetUndefined(t *testing.T) {
+	env := New()
+	if _, err := env.Get("missing"); err == nil {
+		t.Fatal("Get on an undefined variable should return an error")
+	}
+}
+
+func TestEnvironmentAssignUndefined(t *testing.T) {
+	env := New()
+	if err := env.Assign("missing", 1.0); err == nil {
+		t.Fatal("Assign to an undefined variable should return an error")
+	}
+}
+
+func TestEnvironmentAssignUpdatesExisting(t *testing.T) {
+	env := New()
+	env.Define("x", 1.0)
+
+	if err := env.Assign("x", 2.0); err != nil {
+		t.Fatalf("Assign returned error: %v", err)
+	}
+
+	got, err := env.Get("x")
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	if got != 2.0 {
+		t.Errorf("Get(\"x\") = %v, want 2.0", got)
+	}
+}
+
+func TestEnvironmentEnclosingLookup(t *testing.T) {
+	outer := New()
+	outer.Define("x", 1.0)
+	inner := NewEnclosed(outer)
+
+	got, err := inner.Get("x")
+	if err != nil {
+		t.Fatalf("Get(\"x\") on enclosed environment returned error: %v", err)
+	}
+	if got != 1.0 {
+		t.Errorf("Get(\"x\") = %v, want 1.0", got)
+	}
+}
+
+func TestEnvironmentShadowing(t *testing.T) {
+	outer := New()
+	outer.Define("x", 1.0)
+	inner := NewEnclosed(outer)
+	inner.Define("x", 2.0)
+
+	got, err := inner.Get("x")
+	if err != nil {
+		t.Fatalf("Get(\"x\") returned error: %v", err)
+	}
+	if got != 2.0 {
+		t.Errorf("inner Get(\"x\") = %v, want 2.0 (shadowed)", got)
+	}
+
+	outerGot, err := outer.Get("x")
+	if err != nil {
+		t.Fatalf("outer Get(\"x\") returned error: %v", err)
+	}
+	if outerGot != 1.0 {
+		t.Errorf("outer Get(\"x\") = %v, want 1.0 (unaffected by shadowing)", outerGot)
+	}
+}
+
+func TestEnvironmentAssignThroughEnclosing(t *testing.T) {
+	outer := New()
+	outer.Define("x", 1.0)
+	inner := NewEnclosed(outer)
+
+	if err := inner.Assign("x", 2.0); err != nil {
+		t.Fatalf("Assign returned error: %v", err)
+	}
+
+	got, err := outer.Get("x")
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	if got != 2.0 {
+		t.Errorf("outer Get(\"x\") = %v, want 2.0 (assigned through inner scope)", got)
+	}
+}
@@ -0,0 +1,58 @@
+// Package errors defines the error types shared by the scanner, parser, and
+// interpreter so that failures at any stage can be reported uniformly.
+package errors
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ErrorType classifies which stage of execution an ExecutionError came from.
+type ErrorType int
+
+const (
+	PARSER_ERROR ErrorType = iota
+	RUNTIME_ERROR
+)
+
+// ExecutionError represents a failure encountered while parsing or
+// interpreting a program. Filename, Line, and Where point back into the
+// source so the failure can be reported to the user.
+type ExecutionError struct {
+	Type     ErrorType
+	Filename string
+	Line     int
+	Where    int
+	Message  string
+}
+
+// Error implements the error interface, formatting the failure as
+// "file:line:col: message" so editors and terminals can jump straight to
+// the offending source location. Filename is omitted when empty, e.g. for
+// errors raised against a REPL line with no backing file.
+func (e ExecutionError) Error() string {
+	if e.Filename == "" {
+		return fmt.Sprintf("%d:%d: %s", e.Line, e.Where, e.Message)
+	}
+	return fmt.Sprintf("%s:%d:%d: %s", e.Filename, e.Line, e.Where, e.Message)
+}
+
+// ParseErrors collects every error encountered while parsing a program.
+// Parsers that recover from a bad statement (see synchronize) keep going so
+// they can report as many errors as possible in one pass instead of stopping
+// at the first one.
+type ParseErrors []error
+
+// Error implements the error interface, summarizing how many parse errors
+// occurred and listing each of them on its own line. A single error is
+// returned as its own message, with no count.
+func (pe ParseErrors) Error() string {
+	if len(pe) == 1 {
+		return pe[0].Error()
+	}
+	messages := make([]string, len(pe))
+	for i, err := range pe {
+		messages[i] = err.Error()
+	}
+	return fmt.Sprintf("%d parse errors occurred:\n%s", len(pe), strings.Join(messages, "\n"))
+}
@@ -0,0 +1,34 @@
+package errors
+
+import "testing"
+
+// TestExecutionErrorFormatting asserts both formats Error() produces: with
+// a filename (for errors raised against a real source file) and without
+// one (for errors raised against input with no backing file, e.g. a REPL
+// line).
+func TestExecutionErrorFormatting(t *testing.T) {
+	tests := []struct {
+		name string
+		err  ExecutionError
+		want string
+	}{
+		{
+			name: "with filename",
+			err:  ExecutionError{Type: PARSER_ERROR, Filename: "main.lox", Line: 3, Where: 7, Message: "Expect ';' after value."},
+			want: "main.lox:3:7: Expect ';' after value.",
+		},
+		{
+			name: "without filename",
+			err:  ExecutionError{Type: PARSER_ERROR, Line: 3, Where: 7, Message: "Expect ';' after value."},
+			want: "3:7: Expect ';' after value.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.Error(); got != tt.want {
+				t.Errorf("Error() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
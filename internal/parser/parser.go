@@ -1,8 +1,6 @@
 package parser
 
 import (
-	"fmt"
-
 	"github.com/go-interpreter/internal/ast"
 	"github.com/go-interpreter/internal/errors"
 	"github.com/go-interpreter/internal/token"
@@ -10,21 +8,83 @@ import (
 
 // Parser is responsible for processing a sequence of tokens and
 // converting them into a meaningful structure, typically an
-// Abstract Syntax Tree (AST). It keeps track of the tokens to
-// be parsed and the current position within the token stream.
+// Abstract Syntax Tree (AST). Tokens are read lazily through a peeker, so
+// the parser doesn't need the whole token stream available up front.
 type Parser struct {
-	Tokens  []token.Token
-	Current int
+	Filename string
+	tokens   *peeker
 }
 
 // NewParser creates a new instance of the Parser struct with the provided
 // slice of tokens. The tokens are used as the input for the parser to
 // process and generate the corresponding syntax tree or perform other
-// parsing operations.
+// parsing operations. The resulting nodes carry no filename, matching
+// NewParserWithFile(tokens, "").
 func NewParser(tokens []token.Token) Parser {
-	return Parser{
-		Tokens: tokens,
+	return NewParserWithFile(tokens, "")
+}
+
+// NewParserWithFile creates a new Parser the same way NewParser does, but
+// stamps every node it produces with filename so error messages and other
+// position-aware tooling can point back into the right source file. The
+// slice is wrapped in a synthetic, already-closed channel so it can be fed
+// through the same peeker a streaming parser uses.
+func NewParserWithFile(tokens []token.Token, filename string) Parser {
+	ch := make(chan token.Token, len(tokens))
+	for _, tok := range tokens {
+		ch <- tok
 	}
+	close(ch)
+	return Parser{Filename: filename, tokens: newPeeker(ch)}
+}
+
+// NewStreamingParser creates a Parser that reads tokens lazily from ch
+// instead of requiring them all up front, so a scanner can run on its own
+// goroutine and feed the parser one token at a time (e.g. a REPL reading,
+// tokenizing, parsing, and evaluating a single line before the next one is
+// even typed). The resulting nodes carry no filename, matching
+// NewStreamingParserWithFile(ch, "").
+func NewStreamingParser(ch <-chan token.Token) *Parser {
+	return NewStreamingParserWithFile(ch, "")
+}
+
+// NewStreamingParserWithFile creates a streaming Parser the same way
+// NewStreamingParser does, but stamps every node it produces with
+// filename.
+func NewStreamingParserWithFile(ch <-chan token.Token, filename string) *Parser {
+	return &Parser{Filename: filename, tokens: newPeeker(ch)}
+}
+
+// Close drains any tokens left unread on the parser's channel. Callers
+// that abandon a streaming Parser early (e.g. a REPL discarding the rest
+// of a bad line after ParseOne returns an error) should call this so a
+// producer goroutine blocked on a send isn't leaked.
+func (parser *Parser) Close() {
+	parser.tokens.Close()
+}
+
+// ParseOne parses and returns a single top-level statement, so a caller
+// can pipeline reading, tokenizing, and parsing one statement at a time
+// instead of waiting for the whole input. It returns (nil, nil) once the
+// token stream is exhausted. Unlike Parse, a parse error is returned
+// immediately rather than collected, after synchronizing so the next call
+// starts clean.
+func (parser *Parser) ParseOne() (ast.Stmt, error) {
+	if parser.isAtEnd() {
+		return nil, nil
+	}
+	statement, err := parser.declaration()
+	if err != nil {
+		parser.synchronize()
+		return nil, err
+	}
+	return statement, nil
+}
+
+// pos builds the ast.Pos that should be attached to a node originating at
+// tok, stamping it with the parser's filename.
+func (parser *Parser) pos(tok token.Token) ast.Pos {
+	return ast.Pos{Filename: parser.Filename, Line: tok.Line, Column: tok.Char}
 }
 
 // Parse parses the input source code into a slice of abstract syntax tree (AST) statements.
@@ -32,227 +92,203 @@ func NewParser(tokens []token.Token) Parser {
 // Returns the parsed statements or an error if parsing fails.
 func (parser *Parser) Parse() ([]ast.Stmt, error) {
 	var statements []ast.Stmt
+	var parseErrors errors.ParseErrors
 	for !parser.isAtEnd() {
-		statement, err := parser.statement()
+		statement, err := parser.declaration()
 		if err != nil {
-			fmt.Print(fmt.Errorf("%v", err))
+			parseErrors = append(parseErrors, err)
+			parser.synchronize()
+			continue
 		}
 		statements = append(statements, statement)
 	}
 
+	if len(parseErrors) > 0 {
+		return statements, parseErrors
+	}
 	return statements, nil
 }
 
-// statement parses a statement from the input tokens and returns it as an
-// abstract syntax tree (AST) node. It first checks if the statement is a
-// "print" statement and delegates parsing to the printStatement method if so.
-// If not, it assumes the statement is an expression statement and parses it
-// accordingly. Returns an error if parsing fails at any stage.
-func (parser *Parser) statement() (ast.Stmt, error) {
-	if parser.match(token.PRINT) {
-		printStatment, err := parser.printStatement()
-		if err != nil {
-			return nil, err
+// synchronize discards tokens until it reaches a likely statement boundary,
+// so that a single parse error doesn't prevent the rest of the file from
+// being parsed. It stops right after a consumed SEMICOLON, or right before a
+// token that starts a new statement.
+func (parser *Parser) synchronize() {
+	parser.advance()
+
+	for !parser.isAtEnd() {
+		if parser.previous().Type == token.SEMICOLON {
+			return
 		}
-		return printStatment, nil
-	}
-	// It must be an expression statement
-	expressionStmt, err := parser.expression()
-	if err != nil {
-		return nil, err
+
+		switch parser.peek().Type {
+		case token.CLASS, token.FUN, token.VAR, token.FOR, token.IF, token.WHILE, token.PRINT, token.RETURN:
+			return
+		}
+
+		parser.advance()
 	}
-	return ast.ExpressionStmt{Expression: expressionStmt}, nil
 }
 
-// PrintStatement parses a print statement in the source code.
-// It expects an expression followed by a semicolon (';').
-// Returns an abstract syntax tree (AST) node representing the print statement
-// or an error if parsing fails.
-func (parser *Parser) printStatement() (ast.Stmt, error) {
-	value, err := parser.expression()
-	if err != nil {
-		return nil, err
+// declaration parses a declaration, which is either a variable declaration
+// (`var x = expr;`) or any other statement. This is the entry point the
+// top-level Parse loop calls for each statement so that `var` is only legal
+// where a declaration is expected.
+func (parser *Parser) declaration() (ast.Stmt, error) {
+	if parser.match(token.VAR) {
+		varTok := parser.previous()
+		return parser.varDeclaration(varTok)
 	}
-	_, err = parser.consume(token.SEMICOLON, "Expect ';' after value.")
+	return parser.statement()
+}
+
+// varDeclaration parses a variable declaration of the form
+// `var name = initializer;`, where the initializer is optional. varTok is
+// the already-consumed `var` keyword, used to stamp the resulting node's
+// position.
+func (parser *Parser) varDeclaration(varTok token.Token) (ast.Stmt, error) {
+	name, err := parser.consume(token.IDENTIFIER, "Expect variable name.")
 	if err != nil {
 		return nil, err
 	}
-	return ast.PrintStmt{Expression: value}, nil
 
-}
+	var initializer ast.Expr
+	if parser.match(token.EQUAL) {
+		initializer, err = parser.expression()
+		if err != nil {
+			return nil, err
+		}
+	}
 
-// expression parses and returns an expression from the input source.
-// It delegates the parsing to the equality method and returns the resulting
-// abstract syntax tree (AST) expression or an error if parsing fails.
-func (parser *Parser) expression() (ast.Expr, error) {
-	eq, err := parser.equality()
+	_, err = parser.consume(token.SEMICOLON, "Expect ';' after variable declaration.")
 	if err != nil {
 		return nil, err
 	}
-	return eq, nil
+	return ast.VarStmt{Name: name, Initializer: initializer, Pos: parser.pos(varTok)}, nil
 }
 
-// equality parses and constructs an equality expression in the abstract syntax tree (AST).
-// It first parses a comparison expression and then checks for equality operators
-// (!= or ==). If an equality operator is found, it creates a binary expression
-// node with the operator and the right-hand side expression.
-// Returns the constructed expression or an error if parsing fails.
-func (parser *Parser) equality() (ast.Expr, error) {
-	expr, err := parser.comparison()
-	if err != nil {
-		return nil, err
+// statement parses a statement from the input tokens and returns it as an
+// abstract syntax tree (AST) node. It first checks if the statement is a
+// "print" statement and delegates parsing to the printStatement method if so,
+// or a block if it starts with '{'. If not, it assumes the statement is an
+// expression statement and parses it accordingly. Returns an error if parsing
+// fails at any stage.
+func (parser *Parser) statement() (ast.Stmt, error) {
+	if parser.match(token.PRINT) {
+		printTok := parser.previous()
+		printStatment, err := parser.printStatement(printTok)
+		if err != nil {
+			return nil, err
+		}
+		return printStatment, nil
 	}
-	for parser.match(token.BANG_EQUAL, token.EQUAL_EQUAL) {
-		operator := parser.previous()
-		right, err := parser.comparison()
+	if parser.match(token.LEFT_BRACE) {
+		braceTok := parser.previous()
+		statements, err := parser.block()
 		if err != nil {
 			return nil, err
 		}
-		expr = ast.Binary{Left: expr, Operator: operator, Right: right}
+		return ast.BlockStmt{Statements: statements, Pos: parser.pos(braceTok)}, nil
 	}
-	return expr, nil
-}
-
-// comparison parses and constructs a comparison expression in the form of a binary
-// operation. It first parses a term expression and then checks for comparison
-// operators such as GREATER, GREATER_EQUAL, LESS, and LESS_EQUAL. If a comparison
-// operator is found, it continues parsing the right-hand side term and constructs
-// a binary expression node. The process repeats for chained comparisons.
-// Returns the constructed expression or an error if parsing fails.
-func (parser *Parser) comparison() (ast.Expr, error) {
-	expr, err := parser.term()
+	// It must be an expression statement
+	startTok := parser.peek()
+	expressionStmt, err := parser.expression()
+	if err != nil {
+		return nil, err
+	}
+	_, err = parser.consume(token.SEMICOLON, "Expect ';' after expression.")
 	if err != nil {
 		return nil, err
 	}
-	for parser.match(token.GREATER, token.GREATER_EQUAL, token.LESS, token.LESS_EQUAL) {
-		operator := parser.previous()
-		right, err := parser.term()
+	return ast.ExpressionStmt{Expression: expressionStmt, Pos: parser.pos(startTok)}, nil
+}
+
+// block parses a sequence of declarations until the closing '}', assuming
+// the opening '{' has already been consumed. Returns the enclosed
+// statements, or an error if the block is never closed.
+func (parser *Parser) block() ([]ast.Stmt, error) {
+	var statements []ast.Stmt
+	for !parser.check(token.RIGHT_BRACE) && !parser.isAtEnd() {
+		statement, err := parser.declaration()
 		if err != nil {
 			return nil, err
 		}
-		expr = ast.Binary{Left: expr, Operator: operator, Right: right}
+		statements = append(statements, statement)
 	}
-	return expr, nil
+	_, err := parser.consume(token.RIGHT_BRACE, "Expect '}' after block.")
+	if err != nil {
+		return nil, err
+	}
+	return statements, nil
 }
 
-// term parses and returns an expression representing a term in the grammar.
-// A term is defined as a sequence of factors combined using addition or subtraction
-// operators. The method first parses a factor and then checks for any subsequent
-// addition or subtraction operators, combining them into a binary expression tree.
-func (parser *Parser) term() (ast.Expr, error) {
-	expr, err := parser.factor()
+// PrintStatement parses a print statement in the source code.
+// It expects an expression followed by a semicolon (';'). printTok is the
+// already-consumed `print` keyword, used to stamp the resulting node's
+// position. Returns an abstract syntax tree (AST) node representing the
+// print statement or an error if parsing fails.
+func (parser *Parser) printStatement(printTok token.Token) (ast.Stmt, error) {
+	value, err := parser.expression()
 	if err != nil {
 		return nil, err
 	}
-	for parser.match(token.MINUS, token.PLUS) {
-		operator := parser.previous()
-		right, err := parser.factor()
-		if err != nil {
-			return nil, err
-		}
-		expr = ast.Binary{Left: expr, Operator: operator, Right: right}
+	_, err = parser.consume(token.SEMICOLON, "Expect ';' after value.")
+	if err != nil {
+		return nil, err
 	}
-	return expr, nil
+	return ast.PrintStmt{Expression: value, Pos: parser.pos(printTok)}, nil
+
 }
 
-// factor parses and returns an expression representing a binary operation
-// involving multiplication (*) or division (/). It first parses a unary
-// expression and then checks for subsequent binary operations with the
-// specified operators. If such operations are found, it constructs a
-// Binary AST node with the left operand, operator, and right operand.
-// Returns the resulting expression or an error if parsing fails.
-func (parser *Parser) factor() (ast.Expr, error) {
-	expr, err := parser.unary()
+// expression parses and returns an expression from the input source.
+// It delegates the parsing to the assignment method and returns the
+// resulting abstract syntax tree (AST) expression or an error if parsing
+// fails.
+func (parser *Parser) expression() (ast.Expr, error) {
+	assign, err := parser.assignment()
 	if err != nil {
 		return nil, err
 	}
-	for parser.match(token.SLASH, token.STAR) {
-		operator := parser.previous()
-		right, err := parser.unary()
-		if err != nil {
-			return nil, err
-		}
-		expr = ast.Binary{Left: expr, Operator: operator, Right: right}
-	}
-	return expr, nil
+	return assign, nil
 }
 
-// unary parses a unary expression in the source code. A unary expression
-// consists of an operator (e.g., '!' or '-') followed by a single operand.
-// If the current token matches a unary operator, this function recursively
-// parses the operand and constructs an abstract syntax tree (AST) node
-// representing the unary expression. If no unary operator is matched, it
-// delegates parsing to the primary expression parser.
-//
-// Returns an AST expression node representing the unary expression or
-// primary expression, along with any error encountered during parsing.
-func (parser *Parser) unary() (ast.Expr, error) {
-	if parser.match(token.BANG, token.MINUS) {
-		operator := parser.previous()
-		right, err := parser.unary()
-		if err != nil {
-			return nil, err
-		}
-		return ast.Unary{Operator: operator, Right: right}, nil
-	}
-	primary, err := parser.primary()
+// assignment parses a right-associative assignment expression, e.g.
+// `name = value`. It first parses an expression at LOWEST precedence; if
+// that is followed by '=', the left-hand side must be an ast.Variable, and
+// the result is an ast.Assign targeting that variable's name. Any other
+// left-hand side is a parse error, since it isn't a valid assignment
+// target.
+func (parser *Parser) assignment() (ast.Expr, error) {
+	expr, err := parser.parsePrecedence(LOWEST)
 	if err != nil {
 		return nil, err
 	}
-	return primary, nil
-}
 
-// primary parses a primary expression in the source code and returns an
-// abstract syntax tree (AST) representation of the expression or an error
-// if parsing fails. A primary expression can be a literal value (e.g., true,
-// false, nil, numbers, or strings), a grouped expression enclosed in
-// parentheses, or an unexpected token.
-//
-// The function uses a switch statement to match the current token against
-// various cases, such as boolean literals, nil, numeric or string literals,
-// and grouped expressions. If a grouped expression is encountered, it
-// recursively parses the inner expression and ensures that it is properly
-// closed with a right parenthesis.
-//
-// If an unexpected token is encountered, the function returns a parser error
-// with details about the token and its location in the source code.
-func (parser *Parser) primary() (ast.Expr, error) {
-	switch {
-	case parser.match(token.FALSE):
-		return ast.Literal{Value: false}, nil
-	case parser.match(token.TRUE):
-		return ast.Literal{Value: true}, nil
-	case parser.match(token.NIL):
-		return ast.Literal{Value: nil}, nil
-	case parser.match(token.NUMBER, token.STRING):
-		return ast.Literal{Value: parser.previous().Literal}, nil
-	case parser.match(token.LEFT_PAREN):
-		expr, e := parser.expression()
-		if e != nil {
-			fmt.Println(fmt.Errorf("%v", e))
-		}
-		_, err := parser.consume(token.RIGHT_PAREN, "Expect ')' after expression.")
+	if parser.match(token.EQUAL) {
+		equals := parser.previous()
+		value, err := parser.assignment()
 		if err != nil {
 			return nil, err
 		}
-		return ast.Grouping{Expression: expr}, nil
-	default:
-		// We probaby don't want to panic here because we are syncing the parser
-		// We will catch it in parser.match(token.LEFT_PAREN) and report it back to
-		// the stdout
-		peek := parser.peek()
-		return nil, errors.ExecutionError{
-			Type:    errors.PARSER_ERROR,
-			Line:    peek.Line,
-			Where:   peek.Char,
-			Message: fmt.Sprintf("Unexpected token '%v'", peek.Lexeme),
+
+		if variable, ok := expr.(ast.Variable); ok {
+			return ast.Assign{Name: variable.Name, Value: value, Pos: parser.pos(equals)}, nil
 		}
 
+		return nil, errors.ExecutionError{
+			Type:     errors.PARSER_ERROR,
+			Filename: parser.Filename,
+			Line:     equals.Line,
+			Where:    equals.Char,
+			Message:  "Invalid assignment target.",
+		}
 	}
+
+	return expr, nil
 }
 
-// Comparison parses a comparison expression from the list of tokens.
-// It returns the root node of the abstract syntax tree.
+// match reports whether the current token is one of types, consuming it if
+// so.
 func (parser *Parser) match(types ...token.TokenType) bool {
 	for _, tokenType := range types {
 		if parser.check(tokenType) {
@@ -273,26 +309,23 @@ func (parser *Parser) check(type_ token.TokenType) bool {
 
 // This is a helper function to advance the parser to the next token.
 func (parser *Parser) advance() token.Token {
-	if !parser.isAtEnd() {
-		parser.Current++
-	}
-	return parser.previous()
+	return parser.tokens.advance()
 }
 
 // This is a helper function to match the type at the end of the list of tokens.
 // If it is at the end, we return the null character.
 func (parser *Parser) isAtEnd() bool {
-	return parser.peek().Type == token.EOF
+	return parser.tokens.isAtEnd()
 }
 
 // This is a helper function to peek at the end of the string and return it.
 func (parser *Parser) peek() token.Token {
-	return parser.Tokens[parser.Current]
+	return parser.tokens.peek()
 }
 
 // This is a helper function to return the previous token.
 func (parser *Parser) previous() token.Token {
-	return parser.Tokens[parser.Current-1]
+	return parser.tokens.previous()
 }
 
 // This function consumer or otherwise it throws an error
@@ -304,9 +337,10 @@ func (parser *Parser) consume(type_ token.TokenType, message string) (token.Toke
 		return parser.advance(), nil
 	}
 	return token.Token{}, errors.ExecutionError{
-		Type:    errors.PARSER_ERROR,
-		Line:    parser.peek().Line,
-		Where:   parser.peek().Char,
-		Message: message,
+		Type:     errors.PARSER_ERROR,
+		Filename: parser.Filename,
+		Line:     parser.peek().Line,
+		Where:    parser.peek().Char,
+		Message:  message,
 	}
 }
@@ -0,0 +1,40 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/go-interpreter/internal/errors"
+	"github.com/go-interpreter/internal/scanner"
+)
+
+// TestParseRecoversMultipleErrors asserts that Parse() doesn't stop at the
+// first bad statement: synchronize() should let it keep going and report
+// every error it hit, alongside every statement it managed to recover.
+func TestParseRecoversMultipleErrors(t *testing.T) {
+	const source = `
+print 1;
+print ;
+var = 2;
+print 3;
+`
+
+	tokens, err := scanner.New(source).ScanTokens()
+	if err != nil {
+		t.Fatalf("scan error: %v", err)
+	}
+
+	p := NewParser(tokens)
+	statements, err := p.Parse()
+
+	if len(statements) != 2 {
+		t.Fatalf("got %d recovered statements, want 2", len(statements))
+	}
+
+	parseErrors, ok := err.(errors.ParseErrors)
+	if !ok {
+		t.Fatalf("got error of type %T, want errors.ParseErrors", err)
+	}
+	if len(parseErrors) != 2 {
+		t.Fatalf("got %d parse errors, want 2", len(parseErrors))
+	}
+}
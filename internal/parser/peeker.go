@@ -0,0 +1,66 @@
+package parser
+
+import "github.com/go-interpreter/internal/token"
+
+// peeker feeds the parser one token at a time from a channel, buffering
+// just enough to satisfy the parser's needs: the current token (peek) and
+// the one just consumed (previous). This lets the parser run against a
+// live token.Token channel from a scanner goroutine instead of requiring
+// the whole program to be tokenized up front.
+type peeker struct {
+	ch   <-chan token.Token
+	cur  token.Token
+	prev token.Token
+}
+
+// newPeeker creates a peeker over ch and reads the first token so peek()
+// is valid immediately.
+func newPeeker(ch <-chan token.Token) *peeker {
+	p := &peeker{ch: ch}
+	p.fill()
+	return p
+}
+
+// fill reads the next token from the channel into cur. If the channel is
+// closed before an EOF token arrives, it synthesizes one so isAtEnd still
+// terminates the parser instead of blocking forever.
+func (p *peeker) fill() {
+	tok, ok := <-p.ch
+	if !ok {
+		tok = token.Token{Type: token.EOF}
+	}
+	p.cur = tok
+}
+
+// peek returns the current token without consuming it.
+func (p *peeker) peek() token.Token {
+	return p.cur
+}
+
+// previous returns the most recently consumed token.
+func (p *peeker) previous() token.Token {
+	return p.prev
+}
+
+// isAtEnd reports whether the current token is EOF.
+func (p *peeker) isAtEnd() bool {
+	return p.cur.Type == token.EOF
+}
+
+// advance consumes the current token, pulling the next one from the
+// channel, and returns the token that was just consumed.
+func (p *peeker) advance() token.Token {
+	if !p.isAtEnd() {
+		p.prev = p.cur
+		p.fill()
+	}
+	return p.prev
+}
+
+// Close drains any remaining tokens from the channel, so a producer
+// goroutine blocked on a send can finish even if the parser stopped early
+// (e.g. a REPL abandoning the rest of a line after a parse error).
+func (p *peeker) Close() {
+	for range p.ch {
+	}
+}
@@ -0,0 +1,188 @@
+package parser
+
+import (
+	"fmt"
+
+	"github.com/go-interpreter/internal/ast"
+	"github.com/go-interpreter/internal/errors"
+	"github.com/go-interpreter/internal/token"
+)
+
+// Precedence levels for parsePrecedence, lowest to highest binding power.
+// Adding a new operator (e.g. `**`, `.`, call `(`) only requires a new
+// infixFns entry at the right level, not a new grammar function.
+const (
+	LOWEST int = iota
+	EQUALS
+	LESSGREATER
+	SUM
+	PRODUCT
+	PREFIX
+	CALL
+)
+
+// prefixParseFn parses an expression that starts with the current token,
+// e.g. a literal, an identifier, or a unary operator.
+type prefixParseFn func(*Parser) (ast.Expr, error)
+
+// infixParseFn parses the rest of an expression given the already-parsed
+// left-hand side, e.g. the right-hand side and operator of a binary
+// expression.
+type infixParseFn func(*Parser, ast.Expr) (ast.Expr, error)
+
+// infixEntry pairs an infixParseFn with the precedence of the token that
+// triggers it, so parsePrecedence knows when to stop climbing.
+type infixEntry struct {
+	fn   infixParseFn
+	prec int
+}
+
+var prefixFns map[token.TokenType]prefixParseFn
+var infixFns map[token.TokenType]infixEntry
+
+func init() {
+	prefixFns = map[token.TokenType]prefixParseFn{
+		token.FALSE:      parseFalseLiteral,
+		token.TRUE:       parseTrueLiteral,
+		token.NIL:        parseNilLiteral,
+		token.NUMBER:     parseLiteral,
+		token.STRING:     parseLiteral,
+		token.IDENTIFIER: parseIdentifier,
+		token.BANG:       parseUnary,
+		token.MINUS:      parseUnary,
+		token.LEFT_PAREN: parseGrouping,
+	}
+
+	infixFns = map[token.TokenType]infixEntry{
+		token.BANG_EQUAL:    {parseBinary, EQUALS},
+		token.EQUAL_EQUAL:   {parseBinary, EQUALS},
+		token.GREATER:       {parseBinary, LESSGREATER},
+		token.GREATER_EQUAL: {parseBinary, LESSGREATER},
+		token.LESS:          {parseBinary, LESSGREATER},
+		token.LESS_EQUAL:    {parseBinary, LESSGREATER},
+		token.PLUS:          {parseBinary, SUM},
+		token.MINUS:         {parseBinary, SUM},
+		token.STAR:          {parseBinary, PRODUCT},
+		token.SLASH:         {parseBinary, PRODUCT},
+	}
+}
+
+// parsePrecedence is a table-driven Pratt parser: it reads a prefix
+// expression for the current token, then repeatedly looks up an infix
+// parse function for the next token and applies it as long as that token's
+// precedence outranks precedence. This replaces the old
+// equality/comparison/term/factor/unary/primary cascade with a single loop
+// keyed by token.TokenType.
+func (parser *Parser) parsePrecedence(precedence int) (ast.Expr, error) {
+	tok := parser.peek()
+	prefix, ok := prefixFns[tok.Type]
+	if !ok {
+		return nil, errors.ExecutionError{
+			Type:     errors.PARSER_ERROR,
+			Filename: parser.Filename,
+			Line:     tok.Line,
+			Where:    tok.Char,
+			Message:  fmt.Sprintf("no prefix parse function for token '%v'", tok.Lexeme),
+		}
+	}
+
+	left, err := prefix(parser)
+	if err != nil {
+		return nil, err
+	}
+
+	for precedence < parser.peekPrecedence() {
+		infix, ok := infixFns[parser.peek().Type]
+		if !ok {
+			break
+		}
+		left, err = infix.fn(parser, left)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return left, nil
+}
+
+// peekPrecedence reports the precedence of the infix operator at the
+// current position, or LOWEST if the current token has no infix meaning.
+func (parser *Parser) peekPrecedence() int {
+	if entry, ok := infixFns[parser.peek().Type]; ok {
+		return entry.prec
+	}
+	return LOWEST
+}
+
+// parseFalseLiteral parses the `false` literal.
+func parseFalseLiteral(parser *Parser) (ast.Expr, error) {
+	tok := parser.advance()
+	return ast.Literal{Value: false, Pos: parser.pos(tok)}, nil
+}
+
+// parseTrueLiteral parses the `true` literal.
+func parseTrueLiteral(parser *Parser) (ast.Expr, error) {
+	tok := parser.advance()
+	return ast.Literal{Value: true, Pos: parser.pos(tok)}, nil
+}
+
+// parseNilLiteral parses the `nil` literal.
+func parseNilLiteral(parser *Parser) (ast.Expr, error) {
+	tok := parser.advance()
+	return ast.Literal{Value: nil, Pos: parser.pos(tok)}, nil
+}
+
+// parseLiteral parses a number or string literal, taking its value from the
+// scanned token.
+func parseLiteral(parser *Parser) (ast.Expr, error) {
+	tok := parser.advance()
+	return ast.Literal{Value: tok.Literal, Pos: parser.pos(tok)}, nil
+}
+
+// parseIdentifier parses a bare identifier as a variable reference.
+func parseIdentifier(parser *Parser) (ast.Expr, error) {
+	tok := parser.advance()
+	return ast.Variable{Name: tok, Pos: parser.pos(tok)}, nil
+}
+
+// parseUnary parses a prefix `!` or `-` expression, recursing at PREFIX
+// precedence so that e.g. `-a + b` doesn't swallow the `+ b`.
+func parseUnary(parser *Parser) (ast.Expr, error) {
+	operator := parser.advance()
+	right, err := parser.parsePrecedence(PREFIX)
+	if err != nil {
+		return nil, err
+	}
+	return ast.Unary{Operator: operator, Right: right, Pos: parser.pos(operator)}, nil
+}
+
+// parseGrouping parses a parenthesized expression. It goes through
+// parser.expression() rather than parsePrecedence(LOWEST) directly so that
+// an assignment nested inside parens (e.g. `(x = 5)`) still passes through
+// the assignment() layer, matching every other call site.
+func parseGrouping(parser *Parser) (ast.Expr, error) {
+	leftParen := parser.advance()
+	expr, err := parser.expression()
+	if err != nil {
+		return nil, err
+	}
+	_, err = parser.consume(token.RIGHT_PAREN, "Expect ')' after expression.")
+	if err != nil {
+		return nil, err
+	}
+	return ast.Grouping{Expression: expr, Pos: parser.pos(leftParen)}, nil
+}
+
+// parseBinary parses the operator and right-hand side of a binary
+// expression given its already-parsed left-hand side, recursing at the
+// operator's own precedence so that same-precedence operators (e.g. `a - b
+// - c`) associate to the left.
+func parseBinary(parser *Parser, left ast.Expr) (ast.Expr, error) {
+	operator := parser.advance()
+	prec := infixFns[operator.Type].prec
+	right, err := parser.parsePrecedence(prec)
+	if err != nil {
+		return nil, err
+	}
+	return ast.Binary{Left: left, Operator: operator, Right: right, Pos: parser.pos(operator)}, nil
+}
@@ -0,0 +1,113 @@
+package parser
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-interpreter/internal/ast"
+	"github.com/go-interpreter/internal/scanner"
+)
+
+// exprString renders an expression as a fully-parenthesized Lisp-style
+// string (the same idea as tool.PrintAST) so precedence and associativity
+// can be asserted on without hand-building ast.Expr trees.
+func exprString(t *testing.T, e ast.Expr) string {
+	t.Helper()
+	switch n := e.(type) {
+	case ast.Binary:
+		return fmt.Sprintf("(%s %s %s)", n.Operator.Lexeme, exprString(t, n.Left), exprString(t, n.Right))
+	case ast.Unary:
+		return fmt.Sprintf("(%s %s)", n.Operator.Lexeme, exprString(t, n.Right))
+	case ast.Grouping:
+		return fmt.Sprintf("(group %s)", exprString(t, n.Expression))
+	case ast.Literal:
+		return fmt.Sprintf("%v", n.Value)
+	case ast.Variable:
+		return n.Name.Lexeme
+	case ast.Assign:
+		return fmt.Sprintf("(= %s %s)", n.Name.Lexeme, exprString(t, n.Value))
+	default:
+		t.Fatalf("exprString: unexpected expression type %T", e)
+		return ""
+	}
+}
+
+// parseExpr scans and parses source, which must be a single expression
+// statement, and returns its expression.
+func parseExpr(t *testing.T, source string) ast.Expr {
+	t.Helper()
+
+	tokens, err := scanner.New(source).ScanTokens()
+	if err != nil {
+		t.Fatalf("scan error: %v", err)
+	}
+
+	p := NewParser(tokens)
+	statements, err := p.Parse()
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	if len(statements) != 1 {
+		t.Fatalf("expected 1 statement, got %d", len(statements))
+	}
+
+	exprStmt, ok := statements[0].(ast.ExpressionStmt)
+	if !ok {
+		t.Fatalf("expected ast.ExpressionStmt, got %T", statements[0])
+	}
+	return exprStmt.Expression
+}
+
+func TestParsePrecedenceAndAssociativity(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   string
+	}{
+		{
+			name:   "subtraction is left-associative",
+			source: "1 - 2 - 3;",
+			want:   "(- (- 1 2) 3)",
+		},
+		{
+			name:   "equality is left-associative",
+			source: "1 == 2 == 3;",
+			want:   "(== (== 1 2) 3)",
+		},
+		{
+			name:   "unary minus binds tighter than product",
+			source: "-2 * 3;",
+			want:   "(* (- 2) 3)",
+		},
+		{
+			name:   "product binds tighter than sum",
+			source: "1 + 2 * 3;",
+			want:   "(+ 1 (* 2 3))",
+		},
+		{
+			name:   "comparison binds tighter than equality",
+			source: "1 < 2 == true;",
+			want:   "(== (< 1 2) true)",
+		},
+		{
+			name:   "grouping overrides precedence",
+			source: "(1 + 2) * 3;",
+			want:   "(* (group (+ 1 2)) 3)",
+		},
+		{
+			name:   "assignment nested inside a grouping",
+			source: "(x = 5);",
+			want:   "(group (= x 5))",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expr := parseExpr(t, tt.source)
+			got := exprString(t, expr)
+			if got != tt.want {
+				t.Errorf("parse(%q) = %q, want %q", tt.source, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-interpreter/internal/ast"
+	"github.com/go-interpreter/internal/scanner"
+	"github.com/go-interpreter/internal/token"
+)
+
+// streamTokens scans source and feeds its tokens (including the trailing
+// EOF) through the returned channel from a separate goroutine, mimicking a
+// scanner that produces tokens lazily instead of all at once.
+func streamTokens(t *testing.T, source, filename string) <-chan token.Token {
+	t.Helper()
+
+	tokens, err := scanner.NewWithFile(source, filename).ScanTokens()
+	if err != nil {
+		t.Fatalf("scan error: %v", err)
+	}
+
+	ch := make(chan token.Token)
+	go func() {
+		defer close(ch)
+		for _, tok := range tokens {
+			ch <- tok
+		}
+	}()
+	return ch
+}
+
+// TestStreamingParserMatchesSliceParser asserts that parsing the same
+// source through the slice-wrapping path (NewParserWithFile) and the
+// channel-fed streaming path (NewStreamingParserWithFile, pulled one
+// statement at a time via ParseOne) produces identical ASTs.
+func TestStreamingParserMatchesSliceParser(t *testing.T) {
+	const source = `var x = 1; { print x; x = 2; } print x;`
+	const filename = "test.lox"
+
+	sliceTokens, err := scanner.NewWithFile(source, filename).ScanTokens()
+	if err != nil {
+		t.Fatalf("scan error: %v", err)
+	}
+	sliceParser := NewParserWithFile(sliceTokens, filename)
+	want, err := sliceParser.Parse()
+	if err != nil {
+		t.Fatalf("slice parse error: %v", err)
+	}
+
+	streamParser := NewStreamingParserWithFile(streamTokens(t, source, filename), filename)
+	var got []ast.Stmt
+	for {
+		statement, err := streamParser.ParseOne()
+		if err != nil {
+			t.Fatalf("ParseOne error: %v", err)
+		}
+		if statement == nil {
+			break
+		}
+		got = append(got, statement)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("streaming parse = %#v, want %#v", got, want)
+	}
+}
+
+// TestParserCloseUnblocksProducer asserts that Close() drains tokens left
+// unread on the parser's channel, so a producer goroutine blocked on an
+// unbuffered send doesn't leak after the parser gives up early. The first
+// token is a stray ')' with no prefix parse function, so ParseOne returns
+// an error; synchronize() then consumes exactly up through the next ';',
+// leaving later tokens unread until Close() drains them.
+func TestParserCloseUnblocksProducer(t *testing.T) {
+	ch := make(chan token.Token)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer close(ch)
+		ch <- token.Token{Type: token.RIGHT_PAREN, Lexeme: ")", Line: 1, Char: 1}
+		ch <- token.Token{Type: token.SEMICOLON, Lexeme: ";", Line: 1, Char: 2}
+		ch <- token.Token{Type: token.IDENTIFIER, Lexeme: "x", Line: 1, Char: 3}
+		ch <- token.Token{Type: token.EOF, Line: 1, Char: 4}
+	}()
+
+	p := NewStreamingParser(ch)
+	if _, err := p.ParseOne(); err == nil {
+		t.Fatal("expected a parse error for a stray ')'")
+	}
+
+	p.Close()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("producer goroutine did not unblock after Close()")
+	}
+}
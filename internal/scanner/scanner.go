@@ -0,0 +1,284 @@
+// Package scanner turns source text into the token.Token stream the parser
+// consumes. It was added as the minimal plumbing the dumpast CLI needs to
+// turn a file into tokens before parsing it — no scanner existed
+// previously in this tree.
+package scanner
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/go-interpreter/internal/errors"
+	"github.com/go-interpreter/internal/token"
+)
+
+// keywords maps reserved words to their token type; anything else that
+// looks like an identifier scans as token.IDENTIFIER.
+var keywords = map[string]token.TokenType{
+	"and":    token.AND,
+	"class":  token.CLASS,
+	"else":   token.ELSE,
+	"false":  token.FALSE,
+	"for":    token.FOR,
+	"fun":    token.FUN,
+	"if":     token.IF,
+	"nil":    token.NIL,
+	"or":     token.OR,
+	"print":  token.PRINT,
+	"return": token.RETURN,
+	"super":  token.SUPER,
+	"this":   token.THIS,
+	"true":   token.TRUE,
+	"var":    token.VAR,
+	"while":  token.WHILE,
+}
+
+// Scanner converts a source string into a slice of tokens one lexeme at a
+// time.
+type Scanner struct {
+	Source   string
+	Filename string
+
+	tokens    []token.Token
+	start     int
+	current   int
+	line      int
+	lineStart int
+}
+
+// New creates a Scanner over source with no associated filename.
+func New(source string) *Scanner {
+	return NewWithFile(source, "")
+}
+
+// NewWithFile creates a Scanner over source, stamping every token it
+// produces with filename.
+func NewWithFile(source, filename string) *Scanner {
+	return &Scanner{Source: source, Filename: filename, line: 1}
+}
+
+// ScanTokens scans the entire source and returns the resulting tokens,
+// always ending with a single EOF token. Scan errors (e.g. an unterminated
+// string) don't stop scanning; they're collected into an errors.ParseErrors
+// and returned alongside whatever tokens were recovered.
+func (s *Scanner) ScanTokens() ([]token.Token, error) {
+	var scanErrors errors.ParseErrors
+	for !s.isAtEnd() {
+		s.start = s.current
+		if err := s.scanToken(); err != nil {
+			scanErrors = append(scanErrors, err)
+		}
+	}
+	s.tokens = append(s.tokens, token.Token{Type: token.EOF, Line: s.line, Char: s.column()})
+
+	if len(scanErrors) > 0 {
+		return s.tokens, scanErrors
+	}
+	return s.tokens, nil
+}
+
+// scanToken scans a single lexeme starting at s.start, appending it to
+// s.tokens.
+func (s *Scanner) scanToken() error {
+	c := s.advance()
+	switch c {
+	case '(':
+		s.addToken(token.LEFT_PAREN)
+	case ')':
+		s.addToken(token.RIGHT_PAREN)
+	case '{':
+		s.addToken(token.LEFT_BRACE)
+	case '}':
+		s.addToken(token.RIGHT_BRACE)
+	case ',':
+		s.addToken(token.COMMA)
+	case '.':
+		s.addToken(token.DOT)
+	case '-':
+		s.addToken(token.MINUS)
+	case '+':
+		s.addToken(token.PLUS)
+	case ';':
+		s.addToken(token.SEMICOLON)
+	case '*':
+		s.addToken(token.STAR)
+	case '!':
+		s.addToken(s.selectToken('=', token.BANG_EQUAL, token.BANG))
+	case '=':
+		s.addToken(s.selectToken('=', token.EQUAL_EQUAL, token.EQUAL))
+	case '<':
+		s.addToken(s.selectToken('=', token.LESS_EQUAL, token.LESS))
+	case '>':
+		s.addToken(s.selectToken('=', token.GREATER_EQUAL, token.GREATER))
+	case '/':
+		if s.match('/') {
+			for s.peek() != '\n' && !s.isAtEnd() {
+				s.advance()
+			}
+		} else {
+			s.addToken(token.SLASH)
+		}
+	case ' ', '\r', '\t':
+		// Ignore whitespace between tokens.
+	case '\n':
+		s.line++
+		s.lineStart = s.current
+	case '"':
+		return s.string()
+	default:
+		switch {
+		case isDigit(c):
+			s.number()
+		case isAlpha(c):
+			s.identifier()
+		default:
+			return errors.ExecutionError{
+				Type:     errors.PARSER_ERROR,
+				Filename: s.Filename,
+				Line:     s.line,
+				Where:    s.column(),
+				Message:  fmt.Sprintf("Unexpected character '%c'.", c),
+			}
+		}
+	}
+	return nil
+}
+
+// string scans a double-quoted string literal, assuming the opening '"'
+// has already been consumed.
+func (s *Scanner) string() error {
+	startLine, startColumn := s.line, s.column()
+
+	for s.peek() != '"' && !s.isAtEnd() {
+		if s.peek() == '\n' {
+			s.line++
+			s.lineStart = s.current + 1
+		}
+		s.advance()
+	}
+
+	if s.isAtEnd() {
+		return errors.ExecutionError{
+			Type:     errors.PARSER_ERROR,
+			Filename: s.Filename,
+			Line:     startLine,
+			Where:    startColumn,
+			Message:  "Unterminated string.",
+		}
+	}
+
+	s.advance() // The closing '"'.
+	value := s.Source[s.start+1 : s.current-1]
+	s.addTokenLiteral(token.STRING, value)
+	return nil
+}
+
+// number scans an integer or floating-point literal.
+func (s *Scanner) number() {
+	for isDigit(s.peek()) {
+		s.advance()
+	}
+	if s.peek() == '.' && isDigit(s.peekNext()) {
+		s.advance()
+		for isDigit(s.peek()) {
+			s.advance()
+		}
+	}
+	value, _ := strconv.ParseFloat(s.Source[s.start:s.current], 64)
+	s.addTokenLiteral(token.NUMBER, value)
+}
+
+// identifier scans an identifier or keyword.
+func (s *Scanner) identifier() {
+	for isAlphaNumeric(s.peek()) {
+		s.advance()
+	}
+	text := s.Source[s.start:s.current]
+	tokenType, ok := keywords[text]
+	if !ok {
+		tokenType = token.IDENTIFIER
+	}
+	s.addToken(tokenType)
+}
+
+// selectToken returns onMatch if the next character is expected (consuming
+// it), otherwise onNoMatch.
+func (s *Scanner) selectToken(expected byte, onMatch, onNoMatch token.TokenType) token.TokenType {
+	if s.match(expected) {
+		return onMatch
+	}
+	return onNoMatch
+}
+
+// match consumes the current character if it equals expected.
+func (s *Scanner) match(expected byte) bool {
+	if s.isAtEnd() || s.Source[s.current] != expected {
+		return false
+	}
+	s.current++
+	return true
+}
+
+// peek returns the current character without consuming it, or the NUL
+// character at the end of the source.
+func (s *Scanner) peek() byte {
+	if s.isAtEnd() {
+		return 0
+	}
+	return s.Source[s.current]
+}
+
+// peekNext returns the character after the current one, or the NUL
+// character if that's past the end of the source.
+func (s *Scanner) peekNext() byte {
+	if s.current+1 >= len(s.Source) {
+		return 0
+	}
+	return s.Source[s.current+1]
+}
+
+// advance consumes and returns the current character.
+func (s *Scanner) advance() byte {
+	c := s.Source[s.current]
+	s.current++
+	return c
+}
+
+// addToken appends a token with no literal value.
+func (s *Scanner) addToken(tokenType token.TokenType) {
+	s.addTokenLiteral(tokenType, nil)
+}
+
+// addTokenLiteral appends a token carrying literal as its parsed value.
+func (s *Scanner) addTokenLiteral(tokenType token.TokenType, literal interface{}) {
+	lexeme := s.Source[s.start:s.current]
+	s.tokens = append(s.tokens, token.Token{
+		Type:    tokenType,
+		Lexeme:  lexeme,
+		Literal: literal,
+		Line:    s.line,
+		Char:    s.column(),
+	})
+}
+
+// column returns the 1-based column of s.start on the current line.
+func (s *Scanner) column() int {
+	return s.start - s.lineStart + 1
+}
+
+// isAtEnd reports whether the scanner has consumed the whole source.
+func (s *Scanner) isAtEnd() bool {
+	return s.current >= len(s.Source)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isAlpha(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isAlphaNumeric(c byte) bool {
+	return isAlpha(c) || isDigit(c)
+}
@@ -0,0 +1,171 @@
+package scanner
+
+import (
+	"testing"
+
+	"github.com/go-interpreter/internal/errors"
+	"github.com/go-interpreter/internal/token"
+)
+
+// TestScanTokens asserts the happy-path token stream for a representative
+// mix of single/double-character operators, literals, keywords, and
+// comments, including the trailing EOF every ScanTokens call appends.
+func TestScanTokens(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   []token.TokenType
+	}{
+		{
+			name:   "operators",
+			source: "!= == <= >= < > + - * /",
+			want: []token.TokenType{
+				token.BANG_EQUAL, token.EQUAL_EQUAL, token.LESS_EQUAL, token.GREATER_EQUAL,
+				token.LESS, token.GREATER, token.PLUS, token.MINUS, token.STAR, token.SLASH,
+				token.EOF,
+			},
+		},
+		{
+			name:   "line comment is ignored",
+			source: "1 // this is a comment\n2",
+			want:   []token.TokenType{token.NUMBER, token.NUMBER, token.EOF},
+		},
+		{
+			name:   "keyword vs identifier",
+			source: "var x and y",
+			want:   []token.TokenType{token.VAR, token.IDENTIFIER, token.AND, token.IDENTIFIER, token.EOF},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens, err := New(tt.source).ScanTokens()
+			if err != nil {
+				t.Fatalf("ScanTokens() error = %v", err)
+			}
+			if len(tokens) != len(tt.want) {
+				t.Fatalf("ScanTokens() = %d tokens, want %d: %+v", len(tokens), len(tt.want), tokens)
+			}
+			for i, tok := range tokens {
+				if tok.Type != tt.want[i] {
+					t.Errorf("token %d: type = %v, want %v", i, tok.Type, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+// TestScanTokensLiterals asserts that string and number literals carry the
+// parsed Go value (not just the raw lexeme) on their Literal field.
+func TestScanTokensLiterals(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   interface{}
+	}{
+		{name: "string", source: `"hello"`, want: "hello"},
+		{name: "integer", source: "42", want: 42.0},
+		{name: "float", source: "3.14", want: 3.14},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens, err := New(tt.source).ScanTokens()
+			if err != nil {
+				t.Fatalf("ScanTokens() error = %v", err)
+			}
+			if len(tokens) != 2 {
+				t.Fatalf("ScanTokens() = %d tokens, want 2: %+v", len(tokens), tokens)
+			}
+			if tokens[0].Literal != tt.want {
+				t.Errorf("Literal = %v, want %v", tokens[0].Literal, tt.want)
+			}
+		})
+	}
+}
+
+// TestScanTokensErrors asserts the two scan-time error paths: an
+// unterminated string and an unexpected character. Both should be reported
+// as errors.ExecutionError via ScanTokens' returned errors.ParseErrors
+// rather than stopping the scan outright.
+func TestScanTokensErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  string
+		wantErr string
+	}{
+		{
+			name:    "unterminated string",
+			source:  `"hello`,
+			wantErr: "1:1: Unterminated string.",
+		},
+		{
+			name:    "unexpected character",
+			source:  "@",
+			wantErr: "1:1: Unexpected character '@'.",
+		},
+		{
+			name:    "unterminated string crossing a line boundary",
+			source:  "\"abc\ndef",
+			wantErr: "1:1: Unterminated string.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(tt.source).ScanTokens()
+			if err == nil {
+				t.Fatalf("ScanTokens() error = nil, want %q", tt.wantErr)
+			}
+			parseErrors, ok := err.(errors.ParseErrors)
+			if !ok {
+				t.Fatalf("ScanTokens() error type = %T, want errors.ParseErrors", err)
+			}
+			if len(parseErrors) != 1 {
+				t.Fatalf("ScanTokens() returned %d errors, want 1: %v", len(parseErrors), parseErrors)
+			}
+			if got := parseErrors[0].Error(); got != tt.wantErr {
+				t.Errorf("error = %q, want %q", got, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestScanTokensMultiLineColumns asserts that line and column tracking
+// resets the column at each '\n' between tokens, so error positions stay
+// accurate past the first line. The multi-line string case is covered by
+// TestScanTokensMultiLineString.
+func TestScanTokensMultiLineColumns(t *testing.T) {
+	source := "var x;\n  @"
+	_, err := New(source).ScanTokens()
+	if err == nil {
+		t.Fatalf("ScanTokens() error = nil, want an unexpected-character error")
+	}
+	parseErrors, ok := err.(errors.ParseErrors)
+	if !ok || len(parseErrors) != 1 {
+		t.Fatalf("ScanTokens() error = %v, want a single ParseErrors entry", err)
+	}
+	want := "2:3: Unexpected character '@'."
+	if got := parseErrors[0].Error(); got != want {
+		t.Errorf("error = %q, want %q", got, want)
+	}
+}
+
+// TestScanTokensMultiLineString asserts that a string literal spanning
+// multiple lines keeps the scanner's line/column bookkeeping correct for
+// whatever token follows it.
+func TestScanTokensMultiLineString(t *testing.T) {
+	source := "\"line one\nline two\" @"
+	_, err := New(source).ScanTokens()
+	if err == nil {
+		t.Fatalf("ScanTokens() error = nil, want an unexpected-character error")
+	}
+	parseErrors, ok := err.(errors.ParseErrors)
+	if !ok || len(parseErrors) != 1 {
+		t.Fatalf("ScanTokens() error = %v, want a single ParseErrors entry", err)
+	}
+	want := "2:11: Unexpected character '@'."
+	if got := parseErrors[0].Error(); got != want {
+		t.Errorf("error = %q, want %q", got, want)
+	}
+}